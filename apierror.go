@@ -0,0 +1,54 @@
+package openpay
+
+import "fmt"
+
+// Openpay error codes for the sentinel errors below. See
+// https://www.openpay.mx/docs/api/#error-codes for the full list.
+const (
+	errorCodeCardDeclined      = 3001
+	errorCodeInsufficientFunds = 3002
+	errorCodeInvalidCVV        = 2053
+	errorCodeAntifraudRejected = 3005
+)
+
+// Sentinel errors for the Openpay error codes callers most commonly
+// need to branch on. Compare with errors.Is, e.g.
+// errors.Is(err, openpay.ErrCardDeclined).
+var (
+	ErrCardDeclined      = &APIError{ErrorCode: errorCodeCardDeclined}
+	ErrInsufficientFunds = &APIError{ErrorCode: errorCodeInsufficientFunds}
+	ErrInvalidCVV        = &APIError{ErrorCode: errorCodeInvalidCVV}
+	ErrAntifraudRejected = &APIError{ErrorCode: errorCodeAntifraudRejected}
+)
+
+// APIError is the structured error envelope the Openpay API returns for
+// a non-2xx response. Use errors.Is to compare it against a sentinel
+// (ErrCardDeclined, ...) or errors.As to recover the full envelope.
+type APIError struct {
+	ErrorCode   int    `json:"error_code"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	RequestID   string `json:"request_id"`
+
+	// HTTPCode is the HTTP status code the response was sent with. It
+	// is not part of the JSON envelope; perform fills it in from the
+	// response after decoding.
+	HTTPCode int `json:"-"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("openpay: %s (error_code=%d, category=%s, http_code=%d, request_id=%s)",
+		e.Description, e.ErrorCode, e.Category, e.HTTPCode, e.RequestID)
+}
+
+// Is reports whether target is an *APIError with the same ErrorCode,
+// letting callers compare against the package's sentinel errors with
+// errors.Is regardless of the rest of the envelope.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	return e.ErrorCode == t.ErrorCode
+}
@@ -0,0 +1,29 @@
+package openpay
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	err := error(&APIError{ErrorCode: errorCodeCardDeclined, Description: "The card was declined"})
+
+	if !errors.Is(err, ErrCardDeclined) {
+		t.Fatal("errors.Is did not match ErrCardDeclined by error code")
+	}
+	if errors.Is(err, ErrInsufficientFunds) {
+		t.Fatal("errors.Is matched the wrong sentinel")
+	}
+}
+
+func TestAPIErrorAs(t *testing.T) {
+	err := error(&APIError{ErrorCode: errorCodeInvalidCVV, Description: "Invalid CVV", RequestID: "req_123"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatal("errors.As failed to recover the *APIError")
+	}
+	if apiErr.RequestID != "req_123" {
+		t.Fatalf("recovered APIError lost its fields: got RequestID %q", apiErr.RequestID)
+	}
+}
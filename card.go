@@ -0,0 +1,90 @@
+package openpay
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Card is a tokenized card stored on a Customer.
+type Card struct {
+	ID              string    `json:"id"`
+	Type            string    `json:"type"`
+	Brand           string    `json:"brand"`
+	Address         Address   `json:"address"`
+	CardNumber      string    `json:"card_number"`
+	HolderName      string    `json:"holder_name"`
+	ExpirationYear  string    `json:"expiration_year"`
+	ExpirationMonth string    `json:"expiration_month"`
+	AllowsCharges   bool      `json:"allows_charges"`
+	AllowsPayouts   bool      `json:"allows_payouts"`
+	CreationDate    time.Time `json:"creation_date"`
+	BankName        string    `json:"bank_name"`
+	BankCode        string    `json:"bank_code"`
+	CustomerID      string    `json:"customer_id"`
+}
+
+// CardArgs is sent to the Openpay API when attaching a new card to a
+// customer.
+type CardArgs struct {
+	TokenID         string  `json:"token_id,omitempty"`
+	CardNumber      string  `json:"card_number,omitempty"`
+	HolderName      string  `json:"holder_name,omitempty"`
+	ExpirationYear  string  `json:"expiration_year,omitempty"`
+	ExpirationMonth string  `json:"expiration_month,omitempty"`
+	CVV2            string  `json:"cvv2,omitempty"`
+	Address         Address `json:"address,omitempty"`
+	DeviceSessionID string  `json:"device_session_id,omitempty"`
+}
+
+// AddCard attaches a new card to the customer.
+func (c *Customer) AddCard(args *CardArgs) (*Card, error) {
+	return c.AddCardContext(context.Background(), args)
+}
+
+// AddCardContext is AddCard with a caller-supplied context.
+func (c *Customer) AddCardContext(ctx context.Context, args *CardArgs) (*Card, error) {
+	var card Card
+	if err := c.Merchant.performResourceOperation(ctx, "POST", fmt.Sprintf("customers/%s/cards", c.ID), args, &card); err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// GetCards lists every card stored on the customer.
+func (c *Customer) GetCards() ([]Card, error) {
+	return c.GetCardsContext(context.Background())
+}
+
+// GetCardsContext is GetCards with a caller-supplied context.
+func (c *Customer) GetCardsContext(ctx context.Context) ([]Card, error) {
+	var cards []Card
+	if err := c.Merchant.performResourceOperation(ctx, "GET", fmt.Sprintf("customers/%s/cards", c.ID), nil, &cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// GetCard fetches a single card by ID.
+func (c *Customer) GetCard(id string) (*Card, error) {
+	return c.GetCardContext(context.Background(), id)
+}
+
+// GetCardContext is GetCard with a caller-supplied context.
+func (c *Customer) GetCardContext(ctx context.Context, id string) (*Card, error) {
+	var card Card
+	if err := c.Merchant.performResourceOperation(ctx, "GET", fmt.Sprintf("customers/%s/cards/%s", c.ID, id), nil, &card); err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// DeleteCard removes a card from the customer.
+func (c *Customer) DeleteCard(id string) error {
+	return c.DeleteCardContext(context.Background(), id)
+}
+
+// DeleteCardContext is DeleteCard with a caller-supplied context.
+func (c *Customer) DeleteCardContext(ctx context.Context, id string) error {
+	return c.Merchant.performResourceOperation(ctx, "DELETE", fmt.Sprintf("customers/%s/cards/%s", c.ID, id), nil, nil)
+}
@@ -0,0 +1,38 @@
+package openpay
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAddCardPostsToCustomerCards(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Card{ID: "card_1"})
+	c := &Customer{ID: "cus_1", Merchant: testMerchant(srv.URL)}
+
+	card, err := c.AddCard(&CardArgs{TokenID: "tok_1"})
+	if err != nil {
+		t.Fatalf("AddCard: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers/cus_1/cards" {
+		t.Fatalf("got %s %s, want POST /merchant/customers/cus_1/cards", req.Method, req.Path)
+	}
+	if card.ID != "card_1" {
+		t.Fatalf("got card ID %q, want %q", card.ID, "card_1")
+	}
+}
+
+func TestGetCardsGetsCustomerCards(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Card{{ID: "card_1"}})
+	c := &Customer{ID: "cus_1", Merchant: testMerchant(srv.URL)}
+
+	cards, err := c.GetCards()
+	if err != nil {
+		t.Fatalf("GetCards: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/customers/cus_1/cards" {
+		t.Fatalf("got %s %s, want GET /merchant/customers/cus_1/cards", req.Method, req.Path)
+	}
+	if len(cards) != 1 || cards[0].ID != "card_1" {
+		t.Fatalf("got %+v, want one card with ID card_1", cards)
+	}
+}
@@ -0,0 +1,90 @@
+package openpay
+
+import (
+	"context"
+	"fmt"
+)
+
+// Refund is the result of reversing a settled charge.
+type Refund struct {
+	ID            string  `json:"id"`
+	Status        string  `json:"status"`
+	Amount        float64 `json:"amount"`
+	Description   string  `json:"description"`
+	OperationDate string  `json:"operation_date"`
+}
+
+// RefundArgs is sent to the Openpay API when refunding a charge.
+type RefundArgs struct {
+	Description string  `json:"description,omitempty"`
+	Amount      float64 `json:"amount,omitempty"`
+}
+
+// Refund reverses the charge, in full or for the amount given in args.
+// Refund requires the Charge's Merchant to be set, as it is for charges
+// obtained through Customer.ChargeCustomer, Capture, ListCharges or
+// Charges.
+func (ch *Charge) Refund(args *RefundArgs) (*Refund, error) {
+	return ch.RefundContext(context.Background(), args)
+}
+
+// RefundContext is Refund with a caller-supplied context.
+func (ch *Charge) RefundContext(ctx context.Context, args *RefundArgs) (*Refund, error) {
+	var refund Refund
+	path := fmt.Sprintf("customers/%s/charges/%s/refund", ch.CustomerID, ch.ID)
+	if err := ch.Merchant.performResourceOperation(ctx, "POST", path, args, &refund); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// Capture settles a charge that was previously authorized but not
+// captured.
+func (ch *Charge) Capture() (*Charge, error) {
+	return ch.CaptureContext(context.Background())
+}
+
+// CaptureContext is Capture with a caller-supplied context.
+func (ch *Charge) CaptureContext(ctx context.Context) (*Charge, error) {
+	var captured Charge
+	path := fmt.Sprintf("customers/%s/charges/%s/capture", ch.CustomerID, ch.ID)
+	if err := ch.Merchant.performResourceOperation(ctx, "POST", path, nil, &captured); err != nil {
+		return nil, err
+	}
+	captured.Merchant = ch.Merchant
+	return &captured, nil
+}
+
+// ListCharges lists every charge made across the merchant's customers.
+// For merchants with more charges than fit in a single page, prefer
+// Charges, which pages transparently.
+func (m *Merchant) ListCharges() ([]Charge, error) {
+	return m.ListChargesContext(context.Background())
+}
+
+// ListChargesContext is ListCharges with a caller-supplied context.
+func (m *Merchant) ListChargesContext(ctx context.Context) ([]Charge, error) {
+	var charges []Charge
+	if err := m.performResourceOperation(ctx, "GET", "charges", nil, &charges); err != nil {
+		return nil, err
+	}
+	for i := range charges {
+		charges[i].Merchant = m
+	}
+	return charges, nil
+}
+
+// Charges returns a lazy, paginated iterator over every charge made
+// across the merchant's customers.
+func (m *Merchant) Charges(ctx context.Context, params *ListParams) *ChargeIterator {
+	return &ChargeIterator{newPager(ctx, m, "charges", params, func(page []Charge) {
+		for i := range page {
+			page[i].Merchant = m
+		}
+	})}
+}
+
+// ChargeIterator lazily pages through the merchant's charges.
+type ChargeIterator struct {
+	*pager[Charge]
+}
@@ -0,0 +1,70 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestRefundPostsToChargeRefund(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Refund{ID: "trx_1", Status: "completed"})
+	m := testMerchant(srv.URL)
+	ch := &Charge{ID: "ch_1", CustomerID: "cus_1", Merchant: m}
+
+	refund, err := ch.Refund(&RefundArgs{Description: "customer request"})
+	if err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers/cus_1/charges/ch_1/refund" {
+		t.Fatalf("got %s %s, want POST /merchant/customers/cus_1/charges/ch_1/refund", req.Method, req.Path)
+	}
+	var sent RefundArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.Description != "customer request" {
+		t.Fatalf("got body description %q, want %q", sent.Description, "customer request")
+	}
+	if refund.ID != "trx_1" {
+		t.Fatalf("got refund ID %q, want %q", refund.ID, "trx_1")
+	}
+}
+
+func TestCapturePostsToChargeCaptureAndStampsMerchant(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Charge{ID: "ch_1", Status: "completed"})
+	m := testMerchant(srv.URL)
+	ch := &Charge{ID: "ch_1", CustomerID: "cus_1", Merchant: m}
+
+	captured, err := ch.Capture()
+	if err != nil {
+		t.Fatalf("Capture: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers/cus_1/charges/ch_1/capture" {
+		t.Fatalf("got %s %s, want POST /merchant/customers/cus_1/charges/ch_1/capture", req.Method, req.Path)
+	}
+	if captured.Status != "completed" {
+		t.Fatalf("got captured status %q, want %q", captured.Status, "completed")
+	}
+	if captured.Merchant != m {
+		t.Fatal("Capture did not stamp Merchant on the returned charge")
+	}
+}
+
+func TestListChargesGetsChargesAndStampsMerchant(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Charge{{ID: "ch_1"}})
+	m := testMerchant(srv.URL)
+
+	charges, err := m.ListCharges()
+	if err != nil {
+		t.Fatalf("ListCharges: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/charges" {
+		t.Fatalf("got %s %s, want GET /merchant/charges", req.Method, req.Path)
+	}
+	if len(charges) != 1 || charges[0].ID != "ch_1" {
+		t.Fatalf("got %+v, want one charge with ID ch_1", charges)
+	}
+	if charges[0].Merchant != m {
+		t.Fatal("ListCharges did not stamp Merchant on the returned charges")
+	}
+}
@@ -0,0 +1,177 @@
+package openpay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultBaseURL is the production Openpay API endpoint for the Mexico
+// region.
+const defaultBaseURL = "https://api.openpay.mx/v1"
+
+// sandboxBaseURL is the Openpay endpoint for testing against fake
+// processors and cards.
+const sandboxBaseURL = "https://sandbox-api.openpay.mx/v1"
+
+// defaultMaxRetries is how many times a request is retried on a 5xx
+// response or network error before perform gives up.
+const defaultMaxRetries = 2
+
+// defaultRetryBackoff is the base delay of the exponential backoff
+// perform applies between retries.
+const defaultRetryBackoff = 200 * time.Millisecond
+
+// client performs authenticated HTTP calls against the Openpay API on
+// behalf of a Merchant.
+type client struct {
+	merchantID   string
+	privateKey   string
+	baseURL      string
+	httpClient   *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
+
+	requestLogger  func(*http.Request)
+	responseLogger func(*http.Response, time.Duration)
+	tracer         trace.Tracer
+}
+
+// newClient builds a client for the given merchant using the default
+// production endpoint and no observability hooks. NewClient should be
+// preferred when any of those need customizing.
+func newClient(merchantID, privateKey string) *client {
+	return &client{
+		merchantID:   merchantID,
+		privateKey:   privateKey,
+		baseURL:      defaultBaseURL,
+		httpClient:   http.DefaultClient,
+		maxRetries:   defaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
+	}
+}
+
+// newRequest builds an authenticated HTTP request for the given verb and
+// merchant-relative path, bound to ctx. body is JSON-encoded when
+// non-nil. A POST request is given an auto-generated Idempotency-Key
+// unless opts overrides it.
+func (c *client) newRequest(ctx context.Context, verb, path string, body interface{}, opts ...RequestOption) (*http.Request, error) {
+	var buf io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		buf = bytes.NewReader(data)
+	}
+	url := fmt.Sprintf("%s/%s/%s", c.baseURL, c.merchantID, path)
+	req, err := http.NewRequestWithContext(ctx, verb, url, buf)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.privateKey, "")
+	req.Header.Set("Content-Type", "application/json")
+	if verb == http.MethodPost {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Idempotency-Key", key)
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return req, nil
+}
+
+// perform executes req, retrying network errors and 5xx responses with
+// exponential backoff (reusing the same Idempotency-Key on every
+// attempt), and decodes the JSON response body into dst. A non-2xx
+// response is returned as an *APIError when the body is a well-formed
+// Openpay error envelope.
+//
+// When a tracer is configured, the whole call (including retries) runs
+// inside a single span tagged with the HTTP method, resource path and,
+// once known, the Openpay request_id. When request/response loggers are
+// configured, they run around every individual attempt.
+func (c *client) perform(req *http.Request, dst interface{}) error {
+	var span trace.Span
+	if c.tracer != nil {
+		var ctx context.Context
+		ctx, span = c.tracer.Start(req.Context(), fmt.Sprintf("openpay.%s %s", req.Method, req.URL.Path))
+		span.SetAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("openpay.resource", req.URL.Path),
+		)
+		defer span.End()
+		req = req.WithContext(ctx)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(c.retryBackoff * time.Duration(1<<uint(attempt-1)))
+			select {
+			case <-req.Context().Done():
+				timer.Stop()
+				return req.Context().Err()
+			case <-timer.C:
+			}
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return err
+				}
+				req.Body = body
+			}
+		}
+
+		if c.requestLogger != nil {
+			c.requestLogger(req)
+		}
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		elapsed := time.Since(start)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if c.responseLogger != nil {
+			c.responseLogger(resp, elapsed)
+		}
+
+		if resp.StatusCode >= 500 && attempt < c.maxRetries {
+			resp.Body.Close()
+			lastErr = fmt.Errorf("openpay: server error (status %d)", resp.StatusCode)
+			continue
+		}
+
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			var apiErr APIError
+			if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil {
+				return fmt.Errorf("openpay: request failed with status %d", resp.StatusCode)
+			}
+			apiErr.HTTPCode = resp.StatusCode
+			if span != nil {
+				span.SetAttributes(attribute.String("openpay.request_id", apiErr.RequestID))
+			}
+			return &apiErr
+		}
+		if span != nil {
+			span.SetAttributes(attribute.String("openpay.request_id", resp.Header.Get("X-Openpay-Request-Id")))
+		}
+		if dst == nil {
+			return nil
+		}
+		return json.NewDecoder(resp.Body).Decode(dst)
+	}
+	return lastErr
+}
@@ -0,0 +1,141 @@
+package openpay
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRequestSetsIdempotencyKeyOnPost(t *testing.T) {
+	c := testClient("http://example.invalid")
+	req, err := c.newRequest(context.Background(), http.MethodPost, "customers", &CustomerArgs{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	if req.Header.Get("Idempotency-Key") == "" {
+		t.Fatal("expected a POST to get an auto-generated Idempotency-Key, got none")
+	}
+}
+
+func TestNewRequestOmitsIdempotencyKeyOnGet(t *testing.T) {
+	c := testClient("http://example.invalid")
+	req, err := c.newRequest(context.Background(), http.MethodGet, "customers", nil)
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	if req.Header.Get("Idempotency-Key") != "" {
+		t.Fatalf("expected no Idempotency-Key on a GET, got %q", req.Header.Get("Idempotency-Key"))
+	}
+}
+
+func TestWithIdempotencyKeyOverridesAutoGeneratedKey(t *testing.T) {
+	c := testClient("http://example.invalid")
+	req, err := c.newRequest(context.Background(), http.MethodPost, "customers", &CustomerArgs{Name: "Jane"}, WithIdempotencyKey("caller-key"))
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	if got := req.Header.Get("Idempotency-Key"); got != "caller-key" {
+		t.Fatalf("got Idempotency-Key %q, want %q", got, "caller-key")
+	}
+}
+
+func TestPerformResendsSameIdempotencyKeyAcrossRetries(t *testing.T) {
+	var attempts int32
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := testClient(srv.URL)
+	req, err := c.newRequest(context.Background(), http.MethodPost, "customers", &CustomerArgs{Name: "Jane"})
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	if err := c.perform(req, &struct{}{}); err != nil {
+		t.Fatalf("perform: %v", err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(keys))
+	}
+	for i, key := range keys {
+		if key == "" {
+			t.Fatalf("attempt %d: missing Idempotency-Key", i)
+		}
+		if key != keys[0] {
+			t.Fatalf("attempt %d: got Idempotency-Key %q, want %q (same key as the first attempt)", i, key, keys[0])
+		}
+	}
+}
+
+func testClient(baseURL string) *client {
+	c := newClient("merchant", "key")
+	c.baseURL = baseURL
+	c.retryBackoff = 10 * time.Millisecond
+	return c
+}
+
+func TestPerformRetriesOn5xx(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := testClient(srv.URL)
+	req, err := c.newRequest(context.Background(), http.MethodGet, "customers", nil)
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+	if err := c.perform(req, &struct{}{}); err != nil {
+		t.Fatalf("perform: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestPerformStopsRetryingOnContextCancellation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := testClient(srv.URL)
+	c.retryBackoff = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := c.newRequest(ctx, http.MethodGet, "customers", nil)
+	if err != nil {
+		t.Fatalf("newRequest: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- c.perform(req, nil) }()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected context cancellation error, got nil")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("perform did not return promptly after context cancellation")
+	}
+}
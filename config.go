@@ -0,0 +1,138 @@
+package openpay
+
+import (
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Environment selects which Openpay API endpoint a client talks to.
+type Environment string
+
+const (
+	// Production is Openpay's live endpoint. It is the default.
+	Production Environment = "production"
+	// Sandbox is Openpay's endpoint for testing against fake
+	// processors and cards.
+	Sandbox Environment = "sandbox"
+)
+
+// Config holds the options NewClient accepts. Use the With* functions
+// below to build it rather than constructing it directly.
+type Config struct {
+	Environment  Environment
+	HTTPClient   *http.Client
+	BaseURL      string
+	Timeout      time.Duration
+	MaxRetries   int
+	RetryBackoff time.Duration
+
+	requestLogger  func(*http.Request)
+	responseLogger func(*http.Response, time.Duration)
+	tracer         trace.Tracer
+}
+
+// Option configures a Merchant built by NewClient.
+type Option func(*Config)
+
+// WithEnvironment selects the Sandbox or Production endpoint. Ignored
+// if WithBaseURL is also given. Defaults to Production.
+func WithEnvironment(env Environment) Option {
+	return func(c *Config) { c.Environment = env }
+}
+
+// WithHTTPClient overrides the *http.Client used for every request.
+// Defaults to http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Config) { c.HTTPClient = hc }
+}
+
+// WithBaseURL overrides the API base URL outright, taking precedence
+// over WithEnvironment. Useful for testing against a local mock server.
+func WithBaseURL(url string) Option {
+	return func(c *Config) { c.BaseURL = url }
+}
+
+// WithTimeout sets the per-request timeout of the underlying
+// *http.Client.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Config) { c.Timeout = d }
+}
+
+// WithMaxRetries sets how many times a request is retried on a 5xx
+// response or network error. Defaults to 2.
+func WithMaxRetries(n int) Option {
+	return func(c *Config) { c.MaxRetries = n }
+}
+
+// WithRetryBackoff sets the base delay of the exponential backoff
+// applied between retries. Defaults to 200ms.
+func WithRetryBackoff(d time.Duration) Option {
+	return func(c *Config) { c.RetryBackoff = d }
+}
+
+// WithRequestLogger registers fn to run with every outgoing request,
+// including retries.
+func WithRequestLogger(fn func(*http.Request)) Option {
+	return func(c *Config) { c.requestLogger = fn }
+}
+
+// WithResponseLogger registers fn to run with every response received,
+// including retries, alongside how long the round trip took.
+func WithResponseLogger(fn func(*http.Response, time.Duration)) Option {
+	return func(c *Config) { c.responseLogger = fn }
+}
+
+// WithTracer wraps every API call (across its retries) in an
+// OpenTelemetry span started from t (e.g.
+// otel.GetTracerProvider().Tracer("my-service")), tagged with the HTTP
+// method, resource path and Openpay request_id.
+func WithTracer(t trace.Tracer) Option {
+	return func(c *Config) { c.tracer = t }
+}
+
+// NewClient builds a Merchant configured by opts. With no options it is
+// equivalent to NewMerchant: production endpoint, http.DefaultClient,
+// default retry policy, no observability hooks.
+func NewClient(merchantID, privateKey string, opts ...Option) *Merchant {
+	cfg := Config{
+		Environment:  Production,
+		HTTPClient:   http.DefaultClient,
+		MaxRetries:   defaultMaxRetries,
+		RetryBackoff: defaultRetryBackoff,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+		if cfg.Environment == Sandbox {
+			baseURL = sandboxBaseURL
+		}
+	}
+
+	httpClient := cfg.HTTPClient
+	if cfg.Timeout > 0 {
+		withTimeout := *httpClient
+		withTimeout.Timeout = cfg.Timeout
+		httpClient = &withTimeout
+	}
+
+	return &Merchant{
+		ID: merchantID,
+		client: &client{
+			merchantID:     merchantID,
+			privateKey:     privateKey,
+			baseURL:        baseURL,
+			httpClient:     httpClient,
+			maxRetries:     cfg.MaxRetries,
+			retryBackoff:   cfg.RetryBackoff,
+			requestLogger:  cfg.requestLogger,
+			responseLogger: cfg.responseLogger,
+			tracer:         cfg.tracer,
+		},
+	}
+}
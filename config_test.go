@@ -0,0 +1,125 @@
+package openpay
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestNewClientDefaultsToProductionBaseURL(t *testing.T) {
+	m := NewClient("merchant", "key")
+	if m.client.baseURL != defaultBaseURL {
+		t.Fatalf("got base URL %q, want %q", m.client.baseURL, defaultBaseURL)
+	}
+	if m.client.httpClient != http.DefaultClient {
+		t.Fatal("expected the default http.Client to be reused when no options are given")
+	}
+}
+
+func TestWithEnvironmentSandboxUsesSandboxBaseURL(t *testing.T) {
+	m := NewClient("merchant", "key", WithEnvironment(Sandbox))
+	if m.client.baseURL != sandboxBaseURL {
+		t.Fatalf("got base URL %q, want %q", m.client.baseURL, sandboxBaseURL)
+	}
+}
+
+func TestWithBaseURLTakesPrecedenceOverEnvironment(t *testing.T) {
+	m := NewClient("merchant", "key", WithEnvironment(Sandbox), WithBaseURL("https://example.test/v1"))
+	if m.client.baseURL != "https://example.test/v1" {
+		t.Fatalf("got base URL %q, want %q", m.client.baseURL, "https://example.test/v1")
+	}
+}
+
+func TestWithTimeoutSetsClientTimeoutWithoutMutatingDefaultClient(t *testing.T) {
+	m := NewClient("merchant", "key", WithTimeout(5*time.Second))
+	if m.client.httpClient.Timeout != 5*time.Second {
+		t.Fatalf("got http client timeout %v, want %v", m.client.httpClient.Timeout, 5*time.Second)
+	}
+	if http.DefaultClient.Timeout != 0 {
+		t.Fatalf("WithTimeout mutated http.DefaultClient's timeout to %v", http.DefaultClient.Timeout)
+	}
+}
+
+func TestWithMaxRetriesAndRetryBackoffAreThreadedIntoClient(t *testing.T) {
+	m := NewClient("merchant", "key", WithMaxRetries(5), WithRetryBackoff(7*time.Millisecond))
+	if m.client.maxRetries != 5 {
+		t.Fatalf("got maxRetries %d, want %d", m.client.maxRetries, 5)
+	}
+	if m.client.retryBackoff != 7*time.Millisecond {
+		t.Fatalf("got retryBackoff %v, want %v", m.client.retryBackoff, 7*time.Millisecond)
+	}
+}
+
+func TestWithRequestAndResponseLoggerFire(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	var loggedRequests int
+	var loggedResponses int
+	m := NewClient("merchant", "key",
+		WithBaseURL(srv.URL),
+		WithRequestLogger(func(*http.Request) { loggedRequests++ }),
+		WithResponseLogger(func(*http.Response, time.Duration) { loggedResponses++ }),
+	)
+
+	if _, err := m.GetCustomersContext(context.Background()); err != nil {
+		t.Fatalf("GetCustomersContext: %v", err)
+	}
+	if loggedRequests != 1 {
+		t.Fatalf("got %d request logger calls, want 1", loggedRequests)
+	}
+	if loggedResponses != 1 {
+		t.Fatalf("got %d response logger calls, want 1", loggedResponses)
+	}
+}
+
+// fakeTracer records the span names it starts and how many of them were
+// ended, without pulling in the OpenTelemetry SDK.
+type fakeTracer struct {
+	noop.Tracer
+	started []string
+	ended   int
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	t.started = append(t.started, name)
+	return ctx, &fakeSpan{tracer: t}
+}
+
+type fakeSpan struct {
+	noop.Span
+	tracer *fakeTracer
+}
+
+func (s *fakeSpan) End(opts ...trace.SpanEndOption) {
+	s.tracer.ended++
+}
+
+func TestWithTracerWrapsCallInSpan(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	tracer := &fakeTracer{}
+	m := NewClient("merchant", "key", WithBaseURL(srv.URL), WithTracer(tracer))
+
+	if _, err := m.GetCustomersContext(context.Background()); err != nil {
+		t.Fatalf("GetCustomersContext: %v", err)
+	}
+	if len(tracer.started) != 1 {
+		t.Fatalf("got %d spans started, want 1", len(tracer.started))
+	}
+	if tracer.ended != 1 {
+		t.Fatalf("got %d spans ended, want 1", tracer.ended)
+	}
+}
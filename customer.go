@@ -1,6 +1,7 @@
 package openpay
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -105,17 +106,21 @@ type Charge struct {
 		Amount float64 `json:"amount"`
 		Tax    float64 `json:"tax"`
 	} `json:"fee"`
+
+	// Merchant will be set automatically when the charge is obtained
+	// through Customer.ChargeCustomer.
+	Merchant *Merchant
 }
 
 // AddCustomer creates a new customer on the Openpay API.
-func (m *Merchant) AddCustomer(args *CustomerArgs) (*Customer, error) {
-	req, err := m.client.newRequest("POST", "customers", &args)
-	if err != nil {
-		return nil, err
-	}
+func (m *Merchant) AddCustomer(args *CustomerArgs, opts ...RequestOption) (*Customer, error) {
+	return m.AddCustomerContext(context.Background(), args, opts...)
+}
+
+// AddCustomerContext is AddCustomer with a caller-supplied context.
+func (m *Merchant) AddCustomerContext(ctx context.Context, args *CustomerArgs, opts ...RequestOption) (*Customer, error) {
 	var customer Customer
-	err = m.client.perform(req, &customer)
-	if err != nil {
+	if err := m.performResourceOperation(ctx, "POST", "customers", args, &customer, opts...); err != nil {
 		return nil, err
 	}
 	customer.Merchant = m
@@ -124,12 +129,15 @@ func (m *Merchant) AddCustomer(args *CustomerArgs) (*Customer, error) {
 
 // GetCustomers lists all available customers.
 func (m *Merchant) GetCustomers() ([]Customer, error) {
-	req, err := m.client.newRequest("GET", "customers", nil)
-	if err != nil {
-		return nil, err
-	}
+	return m.GetCustomersContext(context.Background())
+}
+
+// GetCustomersContext is GetCustomers with a caller-supplied context. For
+// merchants with more customers than fit in a single page, prefer
+// Customers, which pages transparently.
+func (m *Merchant) GetCustomersContext(ctx context.Context) ([]Customer, error) {
 	var customers []Customer
-	if err = m.client.perform(req, &customers); err != nil {
+	if err := m.performResourceOperation(ctx, "GET", "customers", nil, &customers); err != nil {
 		return nil, err
 	}
 	for i := range customers {
@@ -140,8 +148,13 @@ func (m *Merchant) GetCustomers() ([]Customer, error) {
 
 // GetCustomer gets an Openpay customer.
 func (m *Merchant) GetCustomer(id string) (*Customer, error) {
+	return m.GetCustomerContext(context.Background(), id)
+}
+
+// GetCustomerContext is GetCustomer with a caller-supplied context.
+func (m *Merchant) GetCustomerContext(ctx context.Context, id string) (*Customer, error) {
 	var customer Customer
-	if err := m.performCustomerOperation("GET", id, nil, &customer); err != nil {
+	if err := m.performCustomerOperation(ctx, "GET", id, nil, &customer); err != nil {
 		return nil, err
 	}
 	customer.Merchant = m
@@ -150,8 +163,13 @@ func (m *Merchant) GetCustomer(id string) (*Customer, error) {
 
 // UpdateCustomer updates an existing Openpay customer.
 func (m *Merchant) UpdateCustomer(id string, data *Customer) (*Customer, error) {
+	return m.UpdateCustomerContext(context.Background(), id, data)
+}
+
+// UpdateCustomerContext is UpdateCustomer with a caller-supplied context.
+func (m *Merchant) UpdateCustomerContext(ctx context.Context, id string, data *Customer) (*Customer, error) {
 	var customer Customer
-	if err := m.performCustomerOperation("PUT", id, data, &customer); err != nil {
+	if err := m.performCustomerOperation(ctx, "PUT", id, data, &customer); err != nil {
 		return nil, err
 	}
 	customer.Merchant = m
@@ -160,21 +178,32 @@ func (m *Merchant) UpdateCustomer(id string, data *Customer) (*Customer, error)
 
 // DeleteCustomer deletes an Openpay customer.
 func (m *Merchant) DeleteCustomer(id string) error {
-	return m.performCustomerOperation("DELETE", id, nil, nil)
+	return m.DeleteCustomerContext(context.Background(), id)
 }
 
-func (c *Customer) ChargeCustomer(data, dst interface{}) error {
-	return c.Merchant.performCustomerOperation("POST", fmt.Sprintf("%s/charges", c.ID), data, dst)
+// DeleteCustomerContext is DeleteCustomer with a caller-supplied context.
+func (m *Merchant) DeleteCustomerContext(ctx context.Context, id string) error {
+	return m.performCustomerOperation(ctx, "DELETE", id, nil, nil)
 }
 
-func (m *Merchant) performCustomerOperation(verb, id string, data, dst interface{}) error {
-	client := m.client
-	req, err := client.newRequest(verb, fmt.Sprintf("customers/%s", id), data)
-	if err != nil {
+func (c *Customer) ChargeCustomer(data, dst interface{}, opts ...RequestOption) error {
+	return c.ChargeCustomerContext(context.Background(), data, dst, opts...)
+}
+
+// ChargeCustomerContext is ChargeCustomer with a caller-supplied context.
+// data may be a *ChargeArgs for a stored source_id, or a
+// *WalletChargeArgs to charge a tokenized Apple Pay/Google Pay payload;
+// it is routed to the matching Openpay endpoint automatically.
+func (c *Customer) ChargeCustomerContext(ctx context.Context, data, dst interface{}, opts ...RequestOption) error {
+	if err := c.Merchant.performCustomerOperation(ctx, "POST", chargePath(c.ID, data), data, dst, opts...); err != nil {
 		return err
 	}
-	if err = client.perform(req, dst); err != nil {
-		return err
+	if charge, ok := dst.(*Charge); ok {
+		charge.Merchant = c.Merchant
 	}
 	return nil
 }
+
+func (m *Merchant) performCustomerOperation(ctx context.Context, verb, id string, data, dst interface{}, opts ...RequestOption) error {
+	return m.performResourceOperation(ctx, verb, fmt.Sprintf("customers/%s", id), data, dst, opts...)
+}
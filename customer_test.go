@@ -0,0 +1,124 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestAddCustomerPostsToCustomers(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Customer{ID: "cus_1", Name: "Jane"})
+	m := testMerchant(srv.URL)
+
+	customer, err := m.AddCustomer(&CustomerArgs{Name: "Jane", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("AddCustomer: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers" {
+		t.Fatalf("got %s %s, want POST /merchant/customers", req.Method, req.Path)
+	}
+	var sent CustomerArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.Name != "Jane" || sent.Email != "jane@example.com" {
+		t.Fatalf("got body %+v, want Name Jane Email jane@example.com", sent)
+	}
+	if customer.ID != "cus_1" {
+		t.Fatalf("got customer ID %q, want %q", customer.ID, "cus_1")
+	}
+	if customer.Merchant != m {
+		t.Fatal("AddCustomer did not stamp Merchant on the returned customer")
+	}
+}
+
+func TestGetCustomerGetsCustomerByID(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Customer{ID: "cus_1"})
+	m := testMerchant(srv.URL)
+
+	customer, err := m.GetCustomer("cus_1")
+	if err != nil {
+		t.Fatalf("GetCustomer: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/customers/cus_1" {
+		t.Fatalf("got %s %s, want GET /merchant/customers/cus_1", req.Method, req.Path)
+	}
+	if customer.ID != "cus_1" {
+		t.Fatalf("got customer ID %q, want %q", customer.ID, "cus_1")
+	}
+}
+
+func TestGetCustomersGetsCustomers(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Customer{{ID: "cus_1"}})
+	m := testMerchant(srv.URL)
+
+	customers, err := m.GetCustomers()
+	if err != nil {
+		t.Fatalf("GetCustomers: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/customers" {
+		t.Fatalf("got %s %s, want GET /merchant/customers", req.Method, req.Path)
+	}
+	if len(customers) != 1 || customers[0].ID != "cus_1" {
+		t.Fatalf("got %+v, want one customer with ID cus_1", customers)
+	}
+	if customers[0].Merchant != m {
+		t.Fatal("GetCustomers did not stamp Merchant on the returned customers")
+	}
+}
+
+func TestUpdateCustomerPutsToCustomerID(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Customer{ID: "cus_1", Name: "Jane v2"})
+	m := testMerchant(srv.URL)
+
+	customer, err := m.UpdateCustomer("cus_1", &Customer{Name: "Jane v2"})
+	if err != nil {
+		t.Fatalf("UpdateCustomer: %v", err)
+	}
+	if req.Method != http.MethodPut || req.Path != "/merchant/customers/cus_1" {
+		t.Fatalf("got %s %s, want PUT /merchant/customers/cus_1", req.Method, req.Path)
+	}
+	if customer.Name != "Jane v2" {
+		t.Fatalf("got customer name %q, want %q", customer.Name, "Jane v2")
+	}
+}
+
+func TestDeleteCustomerDeletesCustomerID(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, nil)
+	m := testMerchant(srv.URL)
+
+	if err := m.DeleteCustomer("cus_1"); err != nil {
+		t.Fatalf("DeleteCustomer: %v", err)
+	}
+	if req.Method != http.MethodDelete || req.Path != "/merchant/customers/cus_1" {
+		t.Fatalf("got %s %s, want DELETE /merchant/customers/cus_1", req.Method, req.Path)
+	}
+}
+
+func TestChargeCustomerPostsToCustomerCharges(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Charge{ID: "ch_1", CustomerID: "cus_1"})
+	m := testMerchant(srv.URL)
+	c := &Customer{ID: "cus_1", Merchant: m}
+
+	var charge Charge
+	err := c.ChargeCustomer(&ChargeArgs{Source_id: "card_1", Method: "card", Amount: 100}, &charge)
+	if err != nil {
+		t.Fatalf("ChargeCustomer: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers/cus_1/charges" {
+		t.Fatalf("got %s %s, want POST /merchant/customers/cus_1/charges", req.Method, req.Path)
+	}
+	var sent ChargeArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.Source_id != "card_1" || sent.Amount != 100 {
+		t.Fatalf("got body %+v, want Source_id card_1 Amount 100", sent)
+	}
+	if charge.ID != "ch_1" {
+		t.Fatalf("got charge ID %q, want %q", charge.ID, "ch_1")
+	}
+	if charge.Merchant != m {
+		t.Fatal("ChargeCustomer did not stamp Merchant on the returned charge")
+	}
+}
@@ -0,0 +1,31 @@
+package openpay
+
+import (
+	"context"
+	"time"
+)
+
+// Fee is a charge Openpay makes against the merchant's own balance, e.g.
+// for processing a transaction or chargeback.
+type Fee struct {
+	ID           string    `json:"id"`
+	Operation    string    `json:"operation_type"`
+	Amount       float64   `json:"amount"`
+	Tax          float64   `json:"tax"`
+	CreationDate time.Time `json:"creation_date"`
+}
+
+// ListFees lists every fee Openpay has charged against the merchant's
+// balance.
+func (m *Merchant) ListFees() ([]Fee, error) {
+	return m.ListFeesContext(context.Background())
+}
+
+// ListFeesContext is ListFees with a caller-supplied context.
+func (m *Merchant) ListFeesContext(ctx context.Context) ([]Fee, error) {
+	var fees []Fee
+	if err := m.performResourceOperation(ctx, "GET", "fees", nil, &fees); err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
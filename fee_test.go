@@ -0,0 +1,22 @@
+package openpay
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestListFeesGetsFees(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Fee{{ID: "fee_1", Operation: "charge"}})
+	m := testMerchant(srv.URL)
+
+	fees, err := m.ListFees()
+	if err != nil {
+		t.Fatalf("ListFees: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/fees" {
+		t.Fatalf("got %s %s, want GET /merchant/fees", req.Method, req.Path)
+	}
+	if len(fees) != 1 || fees[0].ID != "fee_1" {
+		t.Fatalf("got %+v, want one fee with ID fee_1", fees)
+	}
+}
@@ -0,0 +1,19 @@
+package openpay
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newIdempotencyKey generates a random UUIDv4 to send as the
+// Idempotency-Key header of a resource-creating request.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,19 @@
+package openpay
+
+// Merchant is an Openpay merchant account. All resource operations
+// (customers, charges, webhooks, ...) are performed on behalf of a
+// Merchant.
+type Merchant struct {
+	ID string
+
+	client *client
+}
+
+// NewMerchant creates a Merchant bound to the given merchant ID and
+// private API key, using Openpay's production endpoint.
+func NewMerchant(merchantID, privateKey string) *Merchant {
+	return &Merchant{
+		ID:     merchantID,
+		client: newClient(merchantID, privateKey),
+	}
+}
@@ -0,0 +1,151 @@
+package openpay
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// defaultPageSize is the page size requested by an iterator when the
+// caller doesn't set ListParams.Limit.
+const defaultPageSize = 100
+
+// ListParams filters and paginates a list endpoint. A nil *ListParams is
+// equivalent to &ListParams{}.
+type ListParams struct {
+	Limit       int
+	Offset      int
+	CreationGTE time.Time
+	CreationLTE time.Time
+	ExternalID  string
+	Status      string
+}
+
+// withDefaults returns a copy of p (or an empty ListParams if p is nil)
+// with Limit set to defaultPageSize when unset.
+func (p *ListParams) withDefaults() *ListParams {
+	params := ListParams{}
+	if p != nil {
+		params = *p
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultPageSize
+	}
+	return &params
+}
+
+// query renders p as a "?..." query string suitable for appending to a
+// list endpoint path.
+func (p *ListParams) query() string {
+	values := url.Values{}
+	if p.Limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", p.Limit))
+	}
+	if p.Offset > 0 {
+		values.Set("offset", fmt.Sprintf("%d", p.Offset))
+	}
+	if !p.CreationGTE.IsZero() {
+		values.Set("creation[gte]", p.CreationGTE.Format("2006-01-02"))
+	}
+	if !p.CreationLTE.IsZero() {
+		values.Set("creation[lte]", p.CreationLTE.Format("2006-01-02"))
+	}
+	if p.ExternalID != "" {
+		values.Set("external_id", p.ExternalID)
+	}
+	if p.Status != "" {
+		values.Set("status", p.Status)
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// pager lazily pages through a list endpoint returning items of type T,
+// fetching a page at a time via merchant.performResourceOperation and
+// tracking position with ListParams.Offset. It backs every *Iterator
+// type in this package; postFetch, if non-nil, runs over each freshly
+// fetched page before it's made visible through Value (e.g. to stamp a
+// back-reference to merchant).
+type pager[T any] struct {
+	ctx       context.Context
+	merchant  *Merchant
+	path      string
+	params    *ListParams
+	postFetch func([]T)
+
+	page []T
+	idx  int
+	err  error
+	done bool
+}
+
+// newPager builds a pager over path, filtered by params.
+func newPager[T any](ctx context.Context, merchant *Merchant, path string, params *ListParams, postFetch func([]T)) *pager[T] {
+	return &pager[T]{ctx: ctx, merchant: merchant, path: path, params: params.withDefaults(), postFetch: postFetch}
+}
+
+// Next advances the pager, fetching the next page transparently when
+// the current one is exhausted. It returns false once iteration is
+// finished or an error occurs; check Err to distinguish the two.
+func (p *pager[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+	if p.idx < len(p.page) {
+		p.idx++
+		return true
+	}
+	if p.done {
+		return false
+	}
+	var page []T
+	if err := p.merchant.performResourceOperation(p.ctx, "GET", p.path+p.params.query(), nil, &page); err != nil {
+		p.err = err
+		return false
+	}
+	if len(page) == 0 {
+		p.done = true
+		return false
+	}
+	if p.postFetch != nil {
+		p.postFetch(page)
+	}
+	p.page = page
+	p.idx = 1
+	p.params.Offset += len(page)
+	if len(page) < p.params.Limit {
+		p.done = true
+	}
+	return true
+}
+
+// Value returns the item at the pager's current position.
+func (p *pager[T]) Value() *T {
+	if p.idx == 0 || p.idx > len(p.page) {
+		return nil
+	}
+	return &p.page[p.idx-1]
+}
+
+// Err returns the first error encountered while paging, if any.
+func (p *pager[T]) Err() error {
+	return p.err
+}
+
+// CustomerIterator lazily pages through a merchant's customers.
+type CustomerIterator struct {
+	*pager[Customer]
+}
+
+// Customers returns a lazy, paginated iterator over the merchant's
+// customers, filtered by params.
+func (m *Merchant) Customers(ctx context.Context, params *ListParams) *CustomerIterator {
+	return &CustomerIterator{newPager(ctx, m, "customers", params, func(page []Customer) {
+		for i := range page {
+			page[i].Merchant = m
+		}
+	})}
+}
@@ -0,0 +1,149 @@
+package openpay
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// customerPageHandler serves GET requests against "customers" by
+// slicing all according to the limit/offset query parameters a pager
+// sends, mimicking how the real Openpay API pages a list endpoint.
+func customerPageHandler(all []Customer, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			atomic.AddInt32(requests, 1)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if offset > len(all) {
+			offset = len(all)
+		}
+		end := offset + limit
+		if end > len(all) {
+			end = len(all)
+		}
+		json.NewEncoder(w).Encode(all[offset:end])
+	}
+}
+
+func drain(it *CustomerIterator) ([]Customer, error) {
+	var got []Customer
+	for it.Next() {
+		got = append(got, *it.Value())
+	}
+	return got, it.Err()
+}
+
+func TestCustomerIteratorPagesAcrossMultiplePages(t *testing.T) {
+	all := make([]Customer, 5)
+	for i := range all {
+		all[i] = Customer{ID: strconv.Itoa(i)}
+	}
+	var requests int32
+	srv := httptest.NewServer(customerPageHandler(all, &requests))
+	defer srv.Close()
+
+	m := &Merchant{ID: "merchant", client: testClient(srv.URL)}
+	it := m.Customers(context.Background(), &ListParams{Limit: 2})
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("iterating: %v", err)
+	}
+	if len(got) != len(all) {
+		t.Fatalf("got %d customers, want %d", len(got), len(all))
+	}
+	for i, c := range got {
+		if c.ID != strconv.Itoa(i) {
+			t.Fatalf("customer %d: got ID %q, want %q", i, c.ID, strconv.Itoa(i))
+		}
+		if c.Merchant != m {
+			t.Fatalf("customer %d: Merchant not stamped by postFetch", i)
+		}
+	}
+	// 5 items at 2/page means 2 full pages plus a final short page (1
+	// item) that itself terminates iteration - no extra empty fetch.
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestCustomerIteratorExactLimitBoundary(t *testing.T) {
+	all := make([]Customer, 4)
+	for i := range all {
+		all[i] = Customer{ID: strconv.Itoa(i)}
+	}
+	var requests int32
+	srv := httptest.NewServer(customerPageHandler(all, &requests))
+	defer srv.Close()
+
+	m := &Merchant{ID: "merchant", client: testClient(srv.URL)}
+	it := m.Customers(context.Background(), &ListParams{Limit: 2})
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("iterating: %v", err)
+	}
+	if len(got) != len(all) {
+		t.Fatalf("got %d customers, want %d", len(got), len(all))
+	}
+	// 4 items at 2/page means two full (len(page) == Limit) pages,
+	// which cannot signal completion by themselves - a third, empty
+	// fetch is required to terminate iteration.
+	if requests != 3 {
+		t.Fatalf("expected 3 requests (two full pages + terminating empty page), got %d", requests)
+	}
+}
+
+func TestCustomerIteratorFinalPageLongerThanOneIsFullyServed(t *testing.T) {
+	all := make([]Customer, 5)
+	for i := range all {
+		all[i] = Customer{ID: strconv.Itoa(i)}
+	}
+	var requests int32
+	srv := httptest.NewServer(customerPageHandler(all, &requests))
+	defer srv.Close()
+
+	m := &Merchant{ID: "merchant", client: testClient(srv.URL)}
+	it := m.Customers(context.Background(), &ListParams{Limit: 3})
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("iterating: %v", err)
+	}
+	if len(got) != len(all) {
+		t.Fatalf("got %d customers, want %d (final page of 2 must be fully served, not just its first item)", len(got), len(all))
+	}
+	for i, c := range got {
+		if c.ID != strconv.Itoa(i) {
+			t.Fatalf("customer %d: got ID %q, want %q", i, c.ID, strconv.Itoa(i))
+		}
+	}
+	// 5 items at 3/page means a full first page (3 items) plus a short
+	// final page (2 items) that itself terminates iteration - no extra
+	// empty fetch.
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestCustomerIteratorEmptyResult(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(customerPageHandler(nil, &requests))
+	defer srv.Close()
+
+	m := &Merchant{ID: "merchant", client: testClient(srv.URL)}
+	it := m.Customers(context.Background(), &ListParams{Limit: 10})
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("iterating: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("got %d customers, want 0", len(got))
+	}
+	if requests != 1 {
+		t.Fatalf("expected a single request for an empty result, got %d", requests)
+	}
+}
@@ -0,0 +1,76 @@
+package openpay
+
+import (
+	"context"
+	"time"
+)
+
+// BankAccount is the destination of a Payout.
+type BankAccount struct {
+	BankCode   string `json:"clabe"`
+	HolderName string `json:"holder_name"`
+	Alias      string `json:"alias,omitempty"`
+}
+
+// Payout is a transfer of funds from the merchant's balance to a bank
+// account.
+type Payout struct {
+	ID           string      `json:"id"`
+	Status       string      `json:"status"`
+	Method       string      `json:"method"`
+	Amount       float64     `json:"amount"`
+	Description  string      `json:"description"`
+	Destination  BankAccount `json:"bank_account"`
+	CreationDate time.Time   `json:"creation_date"`
+}
+
+// PayoutArgs is sent to the Openpay API when creating a payout.
+type PayoutArgs struct {
+	Method      string      `json:"method"`
+	Amount      float64     `json:"amount"`
+	Description string      `json:"description,omitempty"`
+	OrderID     string      `json:"order_id,omitempty"`
+	Destination BankAccount `json:"bank_account"`
+}
+
+// CreatePayout sends funds from the merchant's balance to a bank
+// account.
+func (m *Merchant) CreatePayout(args *PayoutArgs, opts ...RequestOption) (*Payout, error) {
+	return m.CreatePayoutContext(context.Background(), args, opts...)
+}
+
+// CreatePayoutContext is CreatePayout with a caller-supplied context.
+func (m *Merchant) CreatePayoutContext(ctx context.Context, args *PayoutArgs, opts ...RequestOption) (*Payout, error) {
+	var payout Payout
+	if err := m.performResourceOperation(ctx, "POST", "payouts", args, &payout, opts...); err != nil {
+		return nil, err
+	}
+	return &payout, nil
+}
+
+// ListPayouts lists every payout made by the merchant. For merchants
+// with more payouts than fit in a single page, prefer Payouts, which
+// pages transparently.
+func (m *Merchant) ListPayouts() ([]Payout, error) {
+	return m.ListPayoutsContext(context.Background())
+}
+
+// ListPayoutsContext is ListPayouts with a caller-supplied context.
+func (m *Merchant) ListPayoutsContext(ctx context.Context) ([]Payout, error) {
+	var payouts []Payout
+	if err := m.performResourceOperation(ctx, "GET", "payouts", nil, &payouts); err != nil {
+		return nil, err
+	}
+	return payouts, nil
+}
+
+// Payouts returns a lazy, paginated iterator over the merchant's
+// payouts.
+func (m *Merchant) Payouts(ctx context.Context, params *ListParams) *PayoutIterator {
+	return &PayoutIterator{newPager[Payout](ctx, m, "payouts", params, nil)}
+}
+
+// PayoutIterator lazily pages through a merchant's payouts.
+type PayoutIterator struct {
+	*pager[Payout]
+}
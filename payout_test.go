@@ -0,0 +1,47 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreatePayoutPostsToPayouts(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Payout{ID: "payout_1", Amount: 75})
+	m := testMerchant(srv.URL)
+
+	dest := BankAccount{BankCode: "012180001234567895", HolderName: "Jane Doe"}
+	payout, err := m.CreatePayout(&PayoutArgs{Method: "bank_account", Amount: 75, Destination: dest})
+	if err != nil {
+		t.Fatalf("CreatePayout: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/payouts" {
+		t.Fatalf("got %s %s, want POST /merchant/payouts", req.Method, req.Path)
+	}
+	var sent PayoutArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.Destination != dest {
+		t.Fatalf("got body destination %+v, want %+v", sent.Destination, dest)
+	}
+	if payout.ID != "payout_1" {
+		t.Fatalf("got payout ID %q, want %q", payout.ID, "payout_1")
+	}
+}
+
+func TestListPayoutsGetsPayouts(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Payout{{ID: "payout_1"}})
+	m := testMerchant(srv.URL)
+
+	payouts, err := m.ListPayouts()
+	if err != nil {
+		t.Fatalf("ListPayouts: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/payouts" {
+		t.Fatalf("got %s %s, want GET /merchant/payouts", req.Method, req.Path)
+	}
+	if len(payouts) != 1 || payouts[0].ID != "payout_1" {
+		t.Fatalf("got %+v, want one payout with ID payout_1", payouts)
+	}
+}
@@ -0,0 +1,100 @@
+package openpay
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Plan is a recurring billing plan customers can be subscribed to.
+type Plan struct {
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Amount           float64   `json:"amount"`
+	Currency         string    `json:"currency"`
+	RepeatEvery      int       `json:"repeat_every"`
+	RepeatUnit       string    `json:"repeat_unit"`
+	RetryTimes       int       `json:"retry_times"`
+	StatusAfterRetry string    `json:"status_after_retry"`
+	TrialDays        int       `json:"trial_days"`
+	Status           string    `json:"status"`
+	CreationDate     time.Time `json:"creation_date"`
+}
+
+// PlanArgs is sent to the Openpay API when creating or updating a plan.
+type PlanArgs struct {
+	Name        string  `json:"name"`
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency,omitempty"`
+	RepeatEvery int     `json:"repeat_every"`
+	RepeatUnit  string  `json:"repeat_unit"`
+	RetryTimes  int     `json:"retry_times,omitempty"`
+	TrialDays   int     `json:"trial_days,omitempty"`
+}
+
+// CreatePlan creates a new recurring billing plan.
+func (m *Merchant) CreatePlan(args *PlanArgs) (*Plan, error) {
+	return m.CreatePlanContext(context.Background(), args)
+}
+
+// CreatePlanContext is CreatePlan with a caller-supplied context.
+func (m *Merchant) CreatePlanContext(ctx context.Context, args *PlanArgs) (*Plan, error) {
+	var plan Plan
+	if err := m.performResourceOperation(ctx, "POST", "plans", args, &plan); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// UpdatePlan updates an existing plan's name, trial days and retry
+// policy. The amount and billing period cannot be changed once created.
+func (m *Merchant) UpdatePlan(id string, args *PlanArgs) (*Plan, error) {
+	return m.UpdatePlanContext(context.Background(), id, args)
+}
+
+// UpdatePlanContext is UpdatePlan with a caller-supplied context.
+func (m *Merchant) UpdatePlanContext(ctx context.Context, id string, args *PlanArgs) (*Plan, error) {
+	var plan Plan
+	if err := m.performResourceOperation(ctx, "PUT", fmt.Sprintf("plans/%s", id), args, &plan); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// ListPlans lists every plan defined for the merchant.
+func (m *Merchant) ListPlans() ([]Plan, error) {
+	return m.ListPlansContext(context.Background())
+}
+
+// ListPlansContext is ListPlans with a caller-supplied context.
+func (m *Merchant) ListPlansContext(ctx context.Context) ([]Plan, error) {
+	var plans []Plan
+	if err := m.performResourceOperation(ctx, "GET", "plans", nil, &plans); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// GetPlan fetches a single plan by ID.
+func (m *Merchant) GetPlan(id string) (*Plan, error) {
+	return m.GetPlanContext(context.Background(), id)
+}
+
+// GetPlanContext is GetPlan with a caller-supplied context.
+func (m *Merchant) GetPlanContext(ctx context.Context, id string) (*Plan, error) {
+	var plan Plan
+	if err := m.performResourceOperation(ctx, "GET", fmt.Sprintf("plans/%s", id), nil, &plan); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// DeletePlan removes a plan. Existing subscriptions are unaffected.
+func (m *Merchant) DeletePlan(id string) error {
+	return m.DeletePlanContext(context.Background(), id)
+}
+
+// DeletePlanContext is DeletePlan with a caller-supplied context.
+func (m *Merchant) DeletePlanContext(ctx context.Context, id string) error {
+	return m.performResourceOperation(ctx, "DELETE", fmt.Sprintf("plans/%s", id), nil, nil)
+}
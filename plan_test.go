@@ -0,0 +1,58 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreatePlanPostsToPlans(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Plan{ID: "plan_1", Name: "gold"})
+	m := testMerchant(srv.URL)
+
+	plan, err := m.CreatePlan(&PlanArgs{Name: "gold", Amount: 100, RepeatEvery: 1, RepeatUnit: "month"})
+	if err != nil {
+		t.Fatalf("CreatePlan: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/plans" {
+		t.Fatalf("got %s %s, want POST /merchant/plans", req.Method, req.Path)
+	}
+	var sent PlanArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.Name != "gold" {
+		t.Fatalf("got body name %q, want %q", sent.Name, "gold")
+	}
+	if plan.ID != "plan_1" {
+		t.Fatalf("got plan ID %q, want %q", plan.ID, "plan_1")
+	}
+}
+
+func TestUpdatePlanPutsToPlanID(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Plan{ID: "plan_1", Name: "gold-v2"})
+	m := testMerchant(srv.URL)
+
+	if _, err := m.UpdatePlan("plan_1", &PlanArgs{Name: "gold-v2"}); err != nil {
+		t.Fatalf("UpdatePlan: %v", err)
+	}
+	if req.Method != http.MethodPut || req.Path != "/merchant/plans/plan_1" {
+		t.Fatalf("got %s %s, want PUT /merchant/plans/plan_1", req.Method, req.Path)
+	}
+}
+
+func TestListPlansGetsPlans(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Plan{{ID: "plan_1"}})
+	m := testMerchant(srv.URL)
+
+	plans, err := m.ListPlans()
+	if err != nil {
+		t.Fatalf("ListPlans: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/plans" {
+		t.Fatalf("got %s %s, want GET /merchant/plans", req.Method, req.Path)
+	}
+	if len(plans) != 1 || plans[0].ID != "plan_1" {
+		t.Fatalf("got %+v, want one plan with ID plan_1", plans)
+	}
+}
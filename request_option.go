@@ -0,0 +1,16 @@
+package openpay
+
+import "net/http"
+
+// RequestOption customizes a single request after it has been built,
+// e.g. to override the Idempotency-Key openpay auto-generates for
+// resource-creating POSTs.
+type RequestOption func(*http.Request)
+
+// WithIdempotencyKey overrides the auto-generated Idempotency-Key
+// header attached to a resource-creating request.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(r *http.Request) {
+		r.Header.Set("Idempotency-Key", key)
+	}
+}
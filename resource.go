@@ -0,0 +1,16 @@
+package openpay
+
+import "context"
+
+// performResourceOperation issues an authenticated request for path on
+// behalf of m, bound to ctx, and decodes the response into dst. It is
+// the shared base every per-resource operation (customers, cards,
+// plans, subscriptions, transfers, payouts, ...) builds its
+// path-specific helper on top of.
+func (m *Merchant) performResourceOperation(ctx context.Context, verb, path string, data, dst interface{}, opts ...RequestOption) error {
+	req, err := m.client.newRequest(ctx, verb, path, data, opts...)
+	if err != nil {
+		return err
+	}
+	return m.client.perform(req, dst)
+}
@@ -0,0 +1,43 @@
+package openpay
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// capturedRequest records the method, merchant-relative path and body
+// of the single request a capturing test server received.
+type capturedRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// newCapturingServer starts a server that records the request it
+// receives into the returned capturedRequest and replies with status,
+// JSON-encoding respond as the body when respond is non-nil.
+func newCapturingServer(t *testing.T, status int, respond interface{}) (*httptest.Server, *capturedRequest) {
+	t.Helper()
+	captured := &capturedRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		captured.Method = r.Method
+		captured.Path = r.URL.Path
+		captured.Body = body
+		w.WriteHeader(status)
+		if respond != nil {
+			json.NewEncoder(w).Encode(respond)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, captured
+}
+
+// testMerchant builds a Merchant whose client talks to baseURL instead
+// of the real Openpay API.
+func testMerchant(baseURL string) *Merchant {
+	return &Merchant{ID: "merchant", client: testClient(baseURL)}
+}
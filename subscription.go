@@ -0,0 +1,95 @@
+package openpay
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Subscription binds a Customer to a recurring Plan.
+type Subscription struct {
+	ID                  string    `json:"id"`
+	PlanID              string    `json:"plan_id"`
+	Status              string    `json:"status"`
+	ChargeDayOfMonth    int       `json:"charge_day_of_month"`
+	TrialEndDate        time.Time `json:"trial_end_date"`
+	CreationDate        time.Time `json:"creation_date"`
+	CurrentPeriodNumber int       `json:"current_period_number"`
+}
+
+// SubscriptionArgs is sent to the Openpay API when subscribing a
+// customer to a plan.
+type SubscriptionArgs struct {
+	PlanID    string `json:"plan_id"`
+	TrialDays int    `json:"trial_days,omitempty"`
+	SourceID  string `json:"source_id,omitempty"`
+	CardID    string `json:"card_id,omitempty"`
+}
+
+// Subscribe subscribes the customer to a plan.
+func (c *Customer) Subscribe(args *SubscriptionArgs, opts ...RequestOption) (*Subscription, error) {
+	return c.SubscribeContext(context.Background(), args, opts...)
+}
+
+// SubscribeContext is Subscribe with a caller-supplied context.
+func (c *Customer) SubscribeContext(ctx context.Context, args *SubscriptionArgs, opts ...RequestOption) (*Subscription, error) {
+	var subscription Subscription
+	if err := c.Merchant.performResourceOperation(ctx, "POST", fmt.Sprintf("customers/%s/subscriptions", c.ID), args, &subscription, opts...); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// ListSubscriptions lists every subscription held by the customer. For
+// customers with more subscriptions than fit in a single page, prefer
+// Subscriptions, which pages transparently.
+func (c *Customer) ListSubscriptions() ([]Subscription, error) {
+	return c.ListSubscriptionsContext(context.Background())
+}
+
+// ListSubscriptionsContext is ListSubscriptions with a caller-supplied
+// context.
+func (c *Customer) ListSubscriptionsContext(ctx context.Context) ([]Subscription, error) {
+	var subscriptions []Subscription
+	if err := c.Merchant.performResourceOperation(ctx, "GET", fmt.Sprintf("customers/%s/subscriptions", c.ID), nil, &subscriptions); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// Subscriptions returns a lazy, paginated iterator over the customer's
+// subscriptions.
+func (c *Customer) Subscriptions(ctx context.Context, params *ListParams) *SubscriptionIterator {
+	path := fmt.Sprintf("customers/%s/subscriptions", c.ID)
+	return &SubscriptionIterator{newPager[Subscription](ctx, c.Merchant, path, params, nil)}
+}
+
+// GetSubscription fetches a single subscription by ID.
+func (c *Customer) GetSubscription(id string) (*Subscription, error) {
+	return c.GetSubscriptionContext(context.Background(), id)
+}
+
+// GetSubscriptionContext is GetSubscription with a caller-supplied context.
+func (c *Customer) GetSubscriptionContext(ctx context.Context, id string) (*Subscription, error) {
+	var subscription Subscription
+	if err := c.Merchant.performResourceOperation(ctx, "GET", fmt.Sprintf("customers/%s/subscriptions/%s", c.ID, id), nil, &subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// CancelSubscription cancels a customer's subscription.
+func (c *Customer) CancelSubscription(id string) error {
+	return c.CancelSubscriptionContext(context.Background(), id)
+}
+
+// CancelSubscriptionContext is CancelSubscription with a caller-supplied
+// context.
+func (c *Customer) CancelSubscriptionContext(ctx context.Context, id string) error {
+	return c.Merchant.performResourceOperation(ctx, "DELETE", fmt.Sprintf("customers/%s/subscriptions/%s", c.ID, id), nil, nil)
+}
+
+// SubscriptionIterator lazily pages through a customer's subscriptions.
+type SubscriptionIterator struct {
+	*pager[Subscription]
+}
@@ -0,0 +1,46 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSubscribePostsToCustomerSubscriptions(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Subscription{ID: "sub_1", PlanID: "plan_1"})
+	c := &Customer{ID: "cus_1", Merchant: testMerchant(srv.URL)}
+
+	sub, err := c.Subscribe(&SubscriptionArgs{PlanID: "plan_1"})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers/cus_1/subscriptions" {
+		t.Fatalf("got %s %s, want POST /merchant/customers/cus_1/subscriptions", req.Method, req.Path)
+	}
+	var sent SubscriptionArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.PlanID != "plan_1" {
+		t.Fatalf("got body plan_id %q, want %q", sent.PlanID, "plan_1")
+	}
+	if sub.ID != "sub_1" {
+		t.Fatalf("got subscription ID %q, want %q", sub.ID, "sub_1")
+	}
+}
+
+func TestListSubscriptionsGetsCustomerSubscriptions(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Subscription{{ID: "sub_1"}})
+	c := &Customer{ID: "cus_1", Merchant: testMerchant(srv.URL)}
+
+	subs, err := c.ListSubscriptions()
+	if err != nil {
+		t.Fatalf("ListSubscriptions: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/customers/cus_1/subscriptions" {
+		t.Fatalf("got %s %s, want GET /merchant/customers/cus_1/subscriptions", req.Method, req.Path)
+	}
+	if len(subs) != 1 || subs[0].ID != "sub_1" {
+		t.Fatalf("got %+v, want one subscription with ID sub_1", subs)
+	}
+}
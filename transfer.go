@@ -0,0 +1,70 @@
+package openpay
+
+import (
+	"context"
+	"time"
+)
+
+// Transfer is a movement of funds between two customers of the same
+// merchant.
+type Transfer struct {
+	ID           string    `json:"id"`
+	Status       string    `json:"status"`
+	Amount       float64   `json:"amount"`
+	Description  string    `json:"description"`
+	OrderID      string    `json:"order_id"`
+	CreationDate time.Time `json:"creation_date"`
+}
+
+// TransferArgs is sent to the Openpay API by CreateTransfer. CustomerID
+// names the customer that receives the funds.
+type TransferArgs struct {
+	CustomerID  string  `json:"customer_id"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description,omitempty"`
+	OrderID     string  `json:"order_id,omitempty"`
+}
+
+// CreateTransfer moves funds from the merchant's balance to the customer
+// named by args.CustomerID, through the merchant-level transfers
+// endpoint. No source customer can be named, so it does not move funds
+// out of one customer's balance into another's.
+func (m *Merchant) CreateTransfer(args *TransferArgs, opts ...RequestOption) (*Transfer, error) {
+	return m.CreateTransferContext(context.Background(), args, opts...)
+}
+
+// CreateTransferContext is CreateTransfer with a caller-supplied context.
+func (m *Merchant) CreateTransferContext(ctx context.Context, args *TransferArgs, opts ...RequestOption) (*Transfer, error) {
+	var transfer Transfer
+	if err := m.performResourceOperation(ctx, "POST", "transfers", args, &transfer, opts...); err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// ListTransfers lists every transfer made by the merchant. For merchants
+// with more transfers than fit in a single page, prefer Transfers,
+// which pages transparently.
+func (m *Merchant) ListTransfers() ([]Transfer, error) {
+	return m.ListTransfersContext(context.Background())
+}
+
+// ListTransfersContext is ListTransfers with a caller-supplied context.
+func (m *Merchant) ListTransfersContext(ctx context.Context) ([]Transfer, error) {
+	var transfers []Transfer
+	if err := m.performResourceOperation(ctx, "GET", "transfers", nil, &transfers); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+// Transfers returns a lazy, paginated iterator over the merchant's
+// transfers.
+func (m *Merchant) Transfers(ctx context.Context, params *ListParams) *TransferIterator {
+	return &TransferIterator{newPager[Transfer](ctx, m, "transfers", params, nil)}
+}
+
+// TransferIterator lazily pages through a merchant's transfers.
+type TransferIterator struct {
+	*pager[Transfer]
+}
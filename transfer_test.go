@@ -0,0 +1,46 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateTransferPostsToTransfers(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Transfer{ID: "trans_1", Amount: 50})
+	m := testMerchant(srv.URL)
+
+	transfer, err := m.CreateTransfer(&TransferArgs{CustomerID: "cus_1", Amount: 50})
+	if err != nil {
+		t.Fatalf("CreateTransfer: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/transfers" {
+		t.Fatalf("got %s %s, want POST /merchant/transfers", req.Method, req.Path)
+	}
+	var sent TransferArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.CustomerID != "cus_1" || sent.Amount != 50 {
+		t.Fatalf("got body %+v, want CustomerID cus_1 Amount 50", sent)
+	}
+	if transfer.ID != "trans_1" {
+		t.Fatalf("got transfer ID %q, want %q", transfer.ID, "trans_1")
+	}
+}
+
+func TestListTransfersGetsTransfers(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, []Transfer{{ID: "trans_1"}})
+	m := testMerchant(srv.URL)
+
+	transfers, err := m.ListTransfers()
+	if err != nil {
+		t.Fatalf("ListTransfers: %v", err)
+	}
+	if req.Method != http.MethodGet || req.Path != "/merchant/transfers" {
+		t.Fatalf("got %s %s, want GET /merchant/transfers", req.Method, req.Path)
+	}
+	if len(transfers) != 1 || transfers[0].ID != "trans_1" {
+		t.Fatalf("got %+v, want one transfer with ID trans_1", transfers)
+	}
+}
@@ -0,0 +1,89 @@
+package openpay
+
+import (
+	"context"
+	"fmt"
+)
+
+// WalletType identifies the mobile wallet a TokenizedCard payload came
+// from.
+type WalletType string
+
+const (
+	ApplePay  WalletType = "APPLE_PAY"
+	GooglePay WalletType = "GOOGLE_PAY"
+)
+
+// TokenizedCard wraps the opaque payment token a mobile wallet (Apple
+// Pay, Google Pay) hands the merchant's client after the user approves
+// a payment sheet.
+type TokenizedCard struct {
+	Type WalletType             `json:"type"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// WalletChargeArgs is the object sent to the Openpay API when charging a
+// customer with a tokenized wallet payload instead of a stored
+// source_id.
+type WalletChargeArgs struct {
+	Method          string        `json:"method"`
+	Amount          float64       `json:"amount"`
+	Currency        string        `json:"currency"`
+	Description     string        `json:"description"`
+	OrderID         string        `json:"order_id"`
+	DeviceSessionID string        `json:"device_session_id"`
+	Card            TokenizedCard `json:"card"`
+}
+
+// ApplePaySessionRequest is sent to the Openpay API to proxy Apple's
+// merchant validation call.
+type ApplePaySessionRequest struct {
+	MerchantIdentifier string `json:"merchant_identifier"`
+	DisplayName        string `json:"display_name"`
+	Initiative         string `json:"initiative"`
+	InitiativeContext  string `json:"initiative_context"`
+	ValidationURL      string `json:"validation_url"`
+}
+
+// ApplePaySession is the opaque merchant session Apple returns from
+// validation, to be handed back to the client's onvalidatemerchant
+// handler verbatim.
+type ApplePaySession struct {
+	EpochTimestamp            int64  `json:"epochTimestamp"`
+	ExpiresAt                 int64  `json:"expiresAt"`
+	MerchantSessionIdentifier string `json:"merchantSessionIdentifier"`
+	Nonce                     string `json:"nonce"`
+	MerchantIdentifier        string `json:"merchantIdentifier"`
+	DomainName                string `json:"domainName"`
+	DisplayName               string `json:"displayName"`
+	Signature                 string `json:"signature"`
+}
+
+// CreateApplePaySession proxies Apple's merchant validation call through
+// Openpay, so a Go backend can complete the JS onvalidatemerchant
+// handshake without holding its own Apple Pay merchant certificate.
+func (m *Merchant) CreateApplePaySession(args *ApplePaySessionRequest) (*ApplePaySession, error) {
+	return m.CreateApplePaySessionContext(context.Background(), args)
+}
+
+// CreateApplePaySessionContext is CreateApplePaySession with a
+// caller-supplied context.
+func (m *Merchant) CreateApplePaySessionContext(ctx context.Context, args *ApplePaySessionRequest) (*ApplePaySession, error) {
+	var session ApplePaySession
+	if err := m.performResourceOperation(ctx, "POST", "apple_pay/sessions", args, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// chargePath returns the customer-relative charges path to post data to:
+// tokenized wallet payloads are routed to a dedicated endpoint so
+// Openpay can apply wallet-specific token validation, while everything
+// else (ChargeArgs, raw maps, ...) keeps going through the regular
+// charges endpoint.
+func chargePath(customerID string, data interface{}) string {
+	if _, ok := data.(*WalletChargeArgs); ok {
+		return fmt.Sprintf("%s/charges/tokenized", customerID)
+	}
+	return fmt.Sprintf("%s/charges", customerID)
+}
@@ -0,0 +1,63 @@
+package openpay
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestChargeCustomerRoutesWalletChargeToTokenizedEndpoint(t *testing.T) {
+	srv, req := newCapturingServer(t, http.StatusOK, &Charge{ID: "ch_1", CustomerID: "cus_1"})
+	m := testMerchant(srv.URL)
+	c := &Customer{ID: "cus_1", Merchant: m}
+
+	args := &WalletChargeArgs{
+		Method:   "card",
+		Amount:   100,
+		Currency: "MXN",
+		Card: TokenizedCard{
+			Type: ApplePay,
+			Data: map[string]interface{}{"paymentToken": "opaque-token"},
+		},
+	}
+	var charge Charge
+	if err := c.ChargeCustomer(args, &charge); err != nil {
+		t.Fatalf("ChargeCustomer: %v", err)
+	}
+	if req.Method != http.MethodPost || req.Path != "/merchant/customers/cus_1/charges/tokenized" {
+		t.Fatalf("got %s %s, want POST /merchant/customers/cus_1/charges/tokenized", req.Method, req.Path)
+	}
+	var sent WalletChargeArgs
+	if err := json.Unmarshal(req.Body, &sent); err != nil {
+		t.Fatalf("unmarshal request body: %v", err)
+	}
+	if sent.Card.Type != ApplePay || sent.Card.Data["paymentToken"] != "opaque-token" {
+		t.Fatalf("got body card %+v, want ApplePay token opaque-token", sent.Card)
+	}
+	if charge.ID != "ch_1" {
+		t.Fatalf("got charge ID %q, want %q", charge.ID, "ch_1")
+	}
+	if charge.Merchant != m {
+		t.Fatal("ChargeCustomer did not stamp Merchant on the returned charge")
+	}
+}
+
+func TestChargePathRoutesByArgsType(t *testing.T) {
+	tests := []struct {
+		name string
+		data interface{}
+		want string
+	}{
+		{"stored source_id charge", &ChargeArgs{}, "cus_1/charges"},
+		{"tokenized wallet charge", &WalletChargeArgs{}, "cus_1/charges/tokenized"},
+		{"unrecognized args type falls back to the regular endpoint", "not-a-known-args-type", "cus_1/charges"},
+		{"nil data falls back to the regular endpoint", nil, "cus_1/charges"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chargePath("cus_1", tt.data); got != tt.want {
+				t.Fatalf("chargePath(%q, %#v) = %q, want %q", "cus_1", tt.data, got, tt.want)
+			}
+		})
+	}
+}
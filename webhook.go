@@ -0,0 +1,420 @@
+package openpay
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// signatureHeader is the HTTP header Openpay sends the HMAC-SHA256
+// signature of the raw request body in.
+const signatureHeader = "X-Openpay-Signature"
+
+// defaultTimestampTolerance is how far a webhook's event date may drift
+// from the current time before it is rejected as stale.
+const defaultTimestampTolerance = 5 * time.Minute
+
+// maxEventBodySize caps how much of a webhook request body ParseEvent
+// will read. It is mounted as a merchant's public webhook endpoint, so
+// an unauthenticated caller must not be able to exhaust memory with an
+// oversized or slow-drip body before the signature is even checked; a
+// real Openpay event envelope is a few KB at most.
+const maxEventBodySize = 256 * 1024
+
+// Event is the envelope Openpay wraps every webhook notification in.
+type Event struct {
+	Type        string          `json:"type"`
+	EventDate   time.Time       `json:"event_date"`
+	Transaction json.RawMessage `json:"transaction"`
+}
+
+// ChargeEvent is dispatched for charge.* and transaction.* events (e.g.
+// charge.succeeded, charge.refunded, transaction.expired).
+type ChargeEvent struct {
+	Type      string    `json:"type"`
+	EventDate time.Time `json:"event_date"`
+	Charge    Charge    `json:"transaction"`
+}
+
+// PayoutEvent is dispatched for payout.* events.
+type PayoutEvent struct {
+	Type      string    `json:"type"`
+	EventDate time.Time `json:"event_date"`
+	Payout    Payout    `json:"transaction"`
+}
+
+// ChargebackEvent is dispatched for chargeback.* events.
+type ChargebackEvent struct {
+	Type       string     `json:"type"`
+	EventDate  time.Time  `json:"event_date"`
+	Chargeback Chargeback `json:"transaction"`
+}
+
+// FeeEvent is dispatched for fee.* events.
+type FeeEvent struct {
+	Type      string    `json:"type"`
+	EventDate time.Time `json:"event_date"`
+	Fee       Fee       `json:"transaction"`
+}
+
+// TransferEvent is dispatched for transfer.* events.
+type TransferEvent struct {
+	Type      string    `json:"type"`
+	EventDate time.Time `json:"event_date"`
+	Transfer  Transfer  `json:"transaction"`
+}
+
+// SubscriptionEvent is dispatched for subscription.* events.
+type SubscriptionEvent struct {
+	Type         string       `json:"type"`
+	EventDate    time.Time    `json:"event_date"`
+	Subscription Subscription `json:"transaction"`
+}
+
+// Chargeback is a dispute raised against a charge.
+type Chargeback struct {
+	ID           string    `json:"id"`
+	ChargeID     string    `json:"transaction_id"`
+	Status       string    `json:"status"`
+	Reason       string    `json:"reason"`
+	Amount       float64   `json:"amount"`
+	CreationDate time.Time `json:"creation_date"`
+}
+
+// Webhook verifies and dispatches Openpay webhook notifications.
+type Webhook struct {
+	secret    string
+	tolerance time.Duration
+	handlers  map[string][]interface{}
+}
+
+// NewWebhookHandler builds an http.Handler that verifies the HMAC-SHA256
+// signature of incoming Openpay webhook requests against secret and
+// dispatches them to handlers registered with On.
+func NewWebhookHandler(secret string) *Webhook {
+	return &Webhook{
+		secret:    secret,
+		tolerance: defaultTimestampTolerance,
+		handlers:  make(map[string][]interface{}),
+	}
+}
+
+// SetTolerance overrides how far a webhook's event date may drift from
+// the current time before ParseEvent rejects it as stale.
+func (w *Webhook) SetTolerance(d time.Duration) {
+	w.tolerance = d
+}
+
+// On registers fn to run whenever an event of the given type (e.g.
+// "charge.succeeded") is received. fn must be a func(*T) error where T
+// is the typed event matching eventType's prefix (ChargeEvent for
+// "charge."/"transaction.", PayoutEvent for "payout.", ChargebackEvent
+// for "chargeback.", FeeEvent for "fee.", TransferEvent for "transfer."
+// and SubscriptionEvent for "subscription."); On panics immediately if
+// fn's type doesn't match or eventType has no typed event, rather than
+// waiting for a real webhook of that type to surface the mismatch as a
+// dropped event in production. Multiple handlers may be registered for
+// the same type; they run in registration order and the first error
+// aborts the chain.
+func (w *Webhook) On(eventType string, fn interface{}) {
+	want, err := handlerTypeFor(eventType)
+	if err != nil {
+		panic(err)
+	}
+	if got := reflect.TypeOf(fn); got != want {
+		panic(fmt.Sprintf("openpay: On(%q, ...) handler must be %s, got %s", eventType, want, got))
+	}
+	w.handlers[eventType] = append(w.handlers[eventType], fn)
+}
+
+// handlerTypeFor returns the reflect.Type a handler registered for
+// eventType via On must have, based on eventType's prefix. It is an
+// error for dispatch to report no typed event exists for an eventType
+// that reaches ServeHTTP; On uses the same mapping so a misconfigured
+// handler is rejected at registration instead.
+func handlerTypeFor(eventType string) (reflect.Type, error) {
+	switch {
+	case strings.HasPrefix(eventType, "charge."), strings.HasPrefix(eventType, "transaction."):
+		return reflect.TypeOf((func(*ChargeEvent) error)(nil)), nil
+	case strings.HasPrefix(eventType, "payout."):
+		return reflect.TypeOf((func(*PayoutEvent) error)(nil)), nil
+	case strings.HasPrefix(eventType, "chargeback."):
+		return reflect.TypeOf((func(*ChargebackEvent) error)(nil)), nil
+	case strings.HasPrefix(eventType, "fee."):
+		return reflect.TypeOf((func(*FeeEvent) error)(nil)), nil
+	case strings.HasPrefix(eventType, "transfer."):
+		return reflect.TypeOf((func(*TransferEvent) error)(nil)), nil
+	case strings.HasPrefix(eventType, "subscription."):
+		return reflect.TypeOf((func(*SubscriptionEvent) error)(nil)), nil
+	default:
+		return nil, fmt.Errorf("openpay: no typed event for %q", eventType)
+	}
+}
+
+// ParseEvent verifies the signature and freshness of r and decodes its
+// body into an Event.
+func (w *Webhook) ParseEvent(r *http.Request) (*Event, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxEventBodySize))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.verifySignature(r.Header.Get(signatureHeader), body); err != nil {
+		return nil, err
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+
+	if w.tolerance > 0 {
+		if age := time.Since(event.EventDate); age > w.tolerance || age < -w.tolerance {
+			return nil, fmt.Errorf("openpay: webhook event is stale (event_date %s)", event.EventDate)
+		}
+	}
+
+	return &event, nil
+}
+
+// ServeHTTP implements http.Handler. It parses the incoming event and
+// dispatches it to every handler registered for its type, responding
+// 400 on a malformed or unverifiable payload and 500 if any handler
+// returns an error.
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	event, err := w.ParseEvent(r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := w.dispatch(event); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+// dispatch decodes event.Transaction into the typed event matching
+// event.Type's prefix and runs every handler registered for event.Type
+// against it, in registration order, stopping at the first error.
+func (w *Webhook) dispatch(event *Event) error {
+	handlers := w.handlers[event.Type]
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	switch {
+	case strings.HasPrefix(event.Type, "charge."), strings.HasPrefix(event.Type, "transaction."):
+		var charge Charge
+		if err := json.Unmarshal(event.Transaction, &charge); err != nil {
+			return err
+		}
+		typed := &ChargeEvent{Type: event.Type, EventDate: event.EventDate, Charge: charge}
+		for _, h := range handlers {
+			fn, ok := h.(func(*ChargeEvent) error)
+			if !ok {
+				return fmt.Errorf("openpay: handler for %q must be func(*ChargeEvent) error", event.Type)
+			}
+			if err := fn(typed); err != nil {
+				return err
+			}
+		}
+	case strings.HasPrefix(event.Type, "payout."):
+		var payout Payout
+		if err := json.Unmarshal(event.Transaction, &payout); err != nil {
+			return err
+		}
+		typed := &PayoutEvent{Type: event.Type, EventDate: event.EventDate, Payout: payout}
+		for _, h := range handlers {
+			fn, ok := h.(func(*PayoutEvent) error)
+			if !ok {
+				return fmt.Errorf("openpay: handler for %q must be func(*PayoutEvent) error", event.Type)
+			}
+			if err := fn(typed); err != nil {
+				return err
+			}
+		}
+	case strings.HasPrefix(event.Type, "chargeback."):
+		var chargeback Chargeback
+		if err := json.Unmarshal(event.Transaction, &chargeback); err != nil {
+			return err
+		}
+		typed := &ChargebackEvent{Type: event.Type, EventDate: event.EventDate, Chargeback: chargeback}
+		for _, h := range handlers {
+			fn, ok := h.(func(*ChargebackEvent) error)
+			if !ok {
+				return fmt.Errorf("openpay: handler for %q must be func(*ChargebackEvent) error", event.Type)
+			}
+			if err := fn(typed); err != nil {
+				return err
+			}
+		}
+	case strings.HasPrefix(event.Type, "fee."):
+		var fee Fee
+		if err := json.Unmarshal(event.Transaction, &fee); err != nil {
+			return err
+		}
+		typed := &FeeEvent{Type: event.Type, EventDate: event.EventDate, Fee: fee}
+		for _, h := range handlers {
+			fn, ok := h.(func(*FeeEvent) error)
+			if !ok {
+				return fmt.Errorf("openpay: handler for %q must be func(*FeeEvent) error", event.Type)
+			}
+			if err := fn(typed); err != nil {
+				return err
+			}
+		}
+	case strings.HasPrefix(event.Type, "transfer."):
+		var transfer Transfer
+		if err := json.Unmarshal(event.Transaction, &transfer); err != nil {
+			return err
+		}
+		typed := &TransferEvent{Type: event.Type, EventDate: event.EventDate, Transfer: transfer}
+		for _, h := range handlers {
+			fn, ok := h.(func(*TransferEvent) error)
+			if !ok {
+				return fmt.Errorf("openpay: handler for %q must be func(*TransferEvent) error", event.Type)
+			}
+			if err := fn(typed); err != nil {
+				return err
+			}
+		}
+	case strings.HasPrefix(event.Type, "subscription."):
+		var subscription Subscription
+		if err := json.Unmarshal(event.Transaction, &subscription); err != nil {
+			return err
+		}
+		typed := &SubscriptionEvent{Type: event.Type, EventDate: event.EventDate, Subscription: subscription}
+		for _, h := range handlers {
+			fn, ok := h.(func(*SubscriptionEvent) error)
+			if !ok {
+				return fmt.Errorf("openpay: handler for %q must be func(*SubscriptionEvent) error", event.Type)
+			}
+			if err := fn(typed); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("openpay: no typed event for %q", event.Type)
+	}
+	return nil
+}
+
+// verifySignature recomputes the HMAC-SHA256 of body using the webhook
+// secret and compares it against signature in constant time.
+func (w *Webhook) verifySignature(signature string, body []byte) error {
+	if signature == "" {
+		return fmt.Errorf("openpay: missing %s header", signatureHeader)
+	}
+	mac := hmac.New(sha256.New, []byte(w.secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("openpay: invalid webhook signature")
+	}
+	return nil
+}
+
+// WebhookEndpoint is a registered Openpay webhook endpoint.
+type WebhookEndpoint struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	User   string   `json:"user"`
+	Status string   `json:"status"`
+	Events []string `json:"event_types"`
+}
+
+// WebhookEndpointArgs is sent to the Openpay API to create or update a
+// webhook endpoint.
+type WebhookEndpointArgs struct {
+	URL    string   `json:"url"`
+	User   string   `json:"user,omitempty"`
+	Events []string `json:"event_types"`
+}
+
+// MerchantWebhookConfig manages the webhook endpoints registered against
+// a Merchant's /webhooks REST resource.
+type MerchantWebhookConfig struct {
+	merchant *Merchant
+}
+
+// Webhooks returns the MerchantWebhookConfig for m.
+func (m *Merchant) Webhooks() *MerchantWebhookConfig {
+	return &MerchantWebhookConfig{merchant: m}
+}
+
+// Create registers a new webhook endpoint.
+func (c *MerchantWebhookConfig) Create(args *WebhookEndpointArgs) (*WebhookEndpoint, error) {
+	return c.CreateContext(context.Background(), args)
+}
+
+// CreateContext is Create with a caller-supplied context.
+func (c *MerchantWebhookConfig) CreateContext(ctx context.Context, args *WebhookEndpointArgs) (*WebhookEndpoint, error) {
+	var endpoint WebhookEndpoint
+	if err := c.merchant.performResourceOperation(ctx, "POST", "webhooks", args, &endpoint); err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// List returns every webhook endpoint registered for the merchant.
+func (c *MerchantWebhookConfig) List() ([]WebhookEndpoint, error) {
+	return c.ListContext(context.Background())
+}
+
+// ListContext is List with a caller-supplied context.
+func (c *MerchantWebhookConfig) ListContext(ctx context.Context) ([]WebhookEndpoint, error) {
+	var endpoints []WebhookEndpoint
+	if err := c.merchant.performResourceOperation(ctx, "GET", "webhooks", nil, &endpoints); err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+// Get fetches a single webhook endpoint by ID.
+func (c *MerchantWebhookConfig) Get(id string) (*WebhookEndpoint, error) {
+	return c.GetContext(context.Background(), id)
+}
+
+// GetContext is Get with a caller-supplied context.
+func (c *MerchantWebhookConfig) GetContext(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	var endpoint WebhookEndpoint
+	if err := c.merchant.performResourceOperation(ctx, "GET", fmt.Sprintf("webhooks/%s", id), nil, &endpoint); err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// Update updates an existing webhook endpoint's URL, user or subscribed
+// event types.
+func (c *MerchantWebhookConfig) Update(id string, args *WebhookEndpointArgs) (*WebhookEndpoint, error) {
+	return c.UpdateContext(context.Background(), id, args)
+}
+
+// UpdateContext is Update with a caller-supplied context.
+func (c *MerchantWebhookConfig) UpdateContext(ctx context.Context, id string, args *WebhookEndpointArgs) (*WebhookEndpoint, error) {
+	var endpoint WebhookEndpoint
+	if err := c.merchant.performResourceOperation(ctx, "PUT", fmt.Sprintf("webhooks/%s", id), args, &endpoint); err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+// Delete removes a webhook endpoint by ID.
+func (c *MerchantWebhookConfig) Delete(id string) error {
+	return c.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext is Delete with a caller-supplied context.
+func (c *MerchantWebhookConfig) DeleteContext(ctx context.Context, id string) error {
+	return c.merchant.performResourceOperation(ctx, "DELETE", fmt.Sprintf("webhooks/%s", id), nil, nil)
+}
@@ -0,0 +1,137 @@
+package openpay
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookVerifySignature(t *testing.T) {
+	w := NewWebhookHandler("shh")
+	body := []byte(`{"type":"charge.succeeded"}`)
+
+	if err := w.verifySignature(sign("shh", body), body); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+
+	if err := w.verifySignature(sign("wrong-secret", body), body); err == nil {
+		t.Fatal("forged signature accepted")
+	}
+
+	if err := w.verifySignature("", body); err == nil {
+		t.Fatal("missing signature accepted")
+	}
+
+	if err := w.verifySignature("not-hex-and-wrong-length", body); err == nil {
+		t.Fatal("malformed signature accepted")
+	}
+}
+
+func eventBody(t *testing.T, eventDate time.Time) []byte {
+	t.Helper()
+	body, err := json.Marshal(Event{Type: "charge.succeeded", EventDate: eventDate})
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	return body
+}
+
+func TestWebhookParseEventRejectsForgedSignature(t *testing.T) {
+	w := NewWebhookHandler("shh")
+	body := eventBody(t, time.Now())
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, sign("wrong-secret", body))
+	if _, err := w.ParseEvent(req); err == nil {
+		t.Fatal("ParseEvent accepted a forged signature")
+	}
+}
+
+func TestWebhookParseEventTolerance(t *testing.T) {
+	w := NewWebhookHandler("shh")
+	w.SetTolerance(time.Minute)
+
+	fresh := eventBody(t, time.Now())
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(fresh))
+	req.Header.Set(signatureHeader, sign(w.secret, fresh))
+	if _, err := w.ParseEvent(req); err != nil {
+		t.Fatalf("fresh event rejected as stale: %v", err)
+	}
+
+	stale := eventBody(t, time.Now().Add(-2*time.Minute))
+	req = httptest.NewRequest("POST", "/webhook", bytes.NewReader(stale))
+	req.Header.Set(signatureHeader, sign(w.secret, stale))
+	if _, err := w.ParseEvent(req); err == nil {
+		t.Fatal("stale event accepted")
+	}
+
+	future := eventBody(t, time.Now().Add(2*time.Minute))
+	req = httptest.NewRequest("POST", "/webhook", bytes.NewReader(future))
+	req.Header.Set(signatureHeader, sign(w.secret, future))
+	if _, err := w.ParseEvent(req); err == nil {
+		t.Fatal("event too far in the future accepted")
+	}
+}
+
+func TestWebhookOnRejectsMismatchedHandlerType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("On did not panic on a handler signature mismatch")
+		}
+	}()
+	w := NewWebhookHandler("shh")
+	w.On("charge.succeeded", func(*Event) error { return nil })
+}
+
+func TestWebhookOnRejectsUnknownEventType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("On did not panic on an event type with no typed event")
+		}
+	}()
+	w := NewWebhookHandler("shh")
+	w.On("something.unknown", func(*ChargeEvent) error { return nil })
+}
+
+func TestWebhookOnAcceptsMatchingHandlerType(t *testing.T) {
+	w := NewWebhookHandler("shh")
+	w.On("charge.succeeded", func(*ChargeEvent) error { return nil })
+	w.On("payout.created", func(*PayoutEvent) error { return nil })
+	w.On("subscription.charge_failed", func(*SubscriptionEvent) error { return nil })
+}
+
+func TestWebhookParseEventRejectsOversizedBody(t *testing.T) {
+	w := NewWebhookHandler("shh")
+	body := bytes.Repeat([]byte("a"), maxEventBodySize+1)
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, sign(w.secret, body))
+	if _, err := w.ParseEvent(req); err == nil {
+		t.Fatal("ParseEvent read a body past maxEventBodySize")
+	}
+}
+
+func TestWebhookServeHTTPRejectsForgedSignature(t *testing.T) {
+	w := NewWebhookHandler("shh")
+	body := []byte(`{"type":"charge.succeeded","transaction":{}}`)
+
+	req := httptest.NewRequest("POST", "/webhook", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, sign("wrong-secret", body))
+	rw := httptest.NewRecorder()
+	w.ServeHTTP(rw, req)
+
+	if rw.Code != 400 {
+		t.Fatalf("expected 400 for forged signature, got %d", rw.Code)
+	}
+}